@@ -36,6 +36,14 @@ func ResourceObjectLambdaAccessPoint() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidAccountID,
 			},
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"alias_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -110,11 +118,54 @@ func ResourceObjectLambdaAccessPoint() *schema.Resource {
 					},
 				},
 			},
+			"endpoints": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"public_access_block_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"block_public_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ignore_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"restrict_public_buckets": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -159,7 +210,7 @@ func resourceObjectLambdaAccessPointRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	_, err = FindObjectLambdaAccessPointByAccountIDAndName(conn, accountID, name)
+	output, err := FindObjectLambdaAccessPointByAccountIDAndName(conn, accountID, name)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] S3 Object Lambda Access Point (%s) not found, removing from state", d.Id())
@@ -181,10 +232,114 @@ func resourceObjectLambdaAccessPointRead(d *schema.ResourceData, meta interface{
 	d.Set("arn", arn)
 	d.Set("name", name)
 
+	if output.Alias != nil {
+		d.Set("alias", aws.StringValue(output.Alias.Value))
+		d.Set("alias_status", aws.StringValue(output.Alias.Status))
+
+		region := meta.(*conns.AWSClient).Region
+		dnsSuffix := meta.(*conns.AWSClient).DNSSuffix
+		alias := aws.StringValue(output.Alias.Value)
+		d.Set("endpoints", map[string]string{
+			"ipv4":      fmt.Sprintf("%s.s3-object-lambda.%s.%s", alias, region, dnsSuffix),
+			"dualstack": fmt.Sprintf("%s.s3-object-lambda.dualstack.%s.%s", alias, region, dnsSuffix),
+		})
+	}
+
+	// VpcConfiguration and PublicAccessBlockConfiguration are properties of the
+	// supporting access point, not of the Object Lambda access point itself, so
+	// they're surfaced here as read-only by looking up that access point.
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		supportingAccessPointARN := v.([]interface{})[0].(map[string]interface{})["supporting_access_point"].(string)
+
+		supportingAccessPoint, err := findAccessPointByARN(conn, supportingAccessPointARN)
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Access Point (%s): %w", supportingAccessPointARN, err)
+		}
+
+		if err := d.Set("public_access_block_configuration", flattenS3ObjectLambdaAccessPointPublicAccessBlockConfiguration(supportingAccessPoint.PublicAccessBlockConfiguration)); err != nil {
+			return fmt.Errorf("error setting public_access_block_configuration: %w", err)
+		}
+
+		if err := d.Set("vpc_configuration", flattenS3ObjectLambdaAccessPointVpcConfiguration(supportingAccessPoint.VpcConfiguration)); err != nil {
+			return fmt.Errorf("error setting vpc_configuration: %w", err)
+		}
+	}
+
+	configuration, err := conn.GetAccessPointConfigurationForObjectLambda(&s3control.GetAccessPointConfigurationForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	})
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Object Lambda Access Point (%s) configuration not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Object Lambda Access Point (%s) configuration: %w", d.Id(), err)
+	}
+
+	if err := d.Set("configuration", flattenObjectLambdaConfiguration(configuration.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+
 	return nil
 }
 
+func findAccessPointByARN(conn *s3control.S3Control, accessPointARN string) (*s3control.GetAccessPointOutput, error) {
+	parsedARN, err := arn.Parse(accessPointARN)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse ARN (%s): %w", accessPointARN, err)
+	}
+
+	name := strings.TrimPrefix(parsedARN.Resource, "accesspoint/")
+
+	output, err := conn.GetAccessPoint(&s3control.GetAccessPointInput{
+		AccountId: aws.String(parsedARN.AccountID),
+		Name:      aws.String(name),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	return output, nil
+}
+
 func resourceObjectLambdaAccessPointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, name, err := ObjectLambdaAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("configuration") {
+		input := &s3control.PutAccessPointConfigurationForObjectLambdaInput{
+			AccountId: aws.String(accountID),
+			Name:      aws.String(name),
+		}
+
+		if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Configuration = expandObjectLambdaConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating S3 Object Lambda Access Point: %s", input)
+		_, err := conn.PutAccessPointConfigurationForObjectLambda(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating S3 Object Lambda Access Point (%s): %w", d.Id(), err)
+		}
+	}
+
 	return resourceObjectLambdaAccessPointRead(d, meta)
 }
 
@@ -363,52 +518,90 @@ func expandAwsLambdaTransformation(tfMap map[string]interface{}) *s3control.AwsL
 // 	}
 // }
 
-func expandS3ObjectLambdaAccessPointVpcConfiguration(vConfig []interface{}) *s3control.VpcConfiguration {
-	if len(vConfig) == 0 || vConfig[0] == nil {
-		return nil
+func flattenS3ObjectLambdaAccessPointVpcConfiguration(config *s3control.VpcConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
 	}
 
-	mConfig := vConfig[0].(map[string]interface{})
-
-	return &s3control.VpcConfiguration{
-		VpcId: aws.String(mConfig["vpc_id"].(string)),
-	}
+	return []interface{}{map[string]interface{}{
+		"vpc_id": aws.StringValue(config.VpcId),
+	}}
 }
 
-func flattenS3ObjectLambdaAccessPointVpcConfiguration(config *s3control.VpcConfiguration) []interface{} {
+func flattenS3ObjectLambdaAccessPointPublicAccessBlockConfiguration(config *s3control.PublicAccessBlockConfiguration) []interface{} {
 	if config == nil {
 		return []interface{}{}
 	}
 
 	return []interface{}{map[string]interface{}{
-		"vpc_id": aws.StringValue(config.VpcId),
+		"block_public_acls":       aws.BoolValue(config.BlockPublicAcls),
+		"block_public_policy":     aws.BoolValue(config.BlockPublicPolicy),
+		"ignore_public_acls":      aws.BoolValue(config.IgnorePublicAcls),
+		"restrict_public_buckets": aws.BoolValue(config.RestrictPublicBuckets),
 	}}
 }
 
-func expandS3ObjectLambdaAccessPointPublicAccessBlockConfiguration(vConfig []interface{}) *s3control.PublicAccessBlockConfiguration {
-	if len(vConfig) == 0 || vConfig[0] == nil {
-		return nil
+func flattenObjectLambdaConfiguration(apiObject *s3control.ObjectLambdaConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
 	}
 
-	mConfig := vConfig[0].(map[string]interface{})
+	tfMap := map[string]interface{}{
+		"cloud_watch_metrics_enabled":  aws.BoolValue(apiObject.CloudWatchMetricsEnabled),
+		"supporting_access_point":      aws.StringValue(apiObject.SupportingAccessPoint),
+		"transformation_configuration": flattenObjectLambdaTransformationConfigurations(apiObject.TransformationConfigurations),
+	}
 
-	return &s3control.PublicAccessBlockConfiguration{
-		BlockPublicAcls:       aws.Bool(mConfig["block_public_acls"].(bool)),
-		BlockPublicPolicy:     aws.Bool(mConfig["block_public_policy"].(bool)),
-		IgnorePublicAcls:      aws.Bool(mConfig["ignore_public_acls"].(bool)),
-		RestrictPublicBuckets: aws.Bool(mConfig["restrict_public_buckets"].(bool)),
+	if v := apiObject.AllowedFeatures; v != nil {
+		tfMap["allowed_features"] = flex.FlattenStringSet(v)
 	}
+
+	return []interface{}{tfMap}
 }
 
-func flattenS3ObjectLambdaAccessPointPublicAccessBlockConfiguration(config *s3control.PublicAccessBlockConfiguration) []interface{} {
-	if config == nil {
+func flattenObjectLambdaTransformationConfigurations(apiObjects []*s3control.ObjectLambdaTransformationConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return []interface{}{}
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"actions":                flex.FlattenStringSet(apiObject.Actions),
+			"content_transformation": flattenObjectLambdaContentTransformation(apiObject.ContentTransformation),
+		})
+	}
+
+	return tfList
+}
+
+func flattenObjectLambdaContentTransformation(apiObject *s3control.ObjectLambdaContentTransformation) []interface{} {
+	if apiObject == nil {
 		return []interface{}{}
 	}
 
 	return []interface{}{map[string]interface{}{
-		"block_public_acls":       aws.BoolValue(config.BlockPublicAcls),
-		"block_public_policy":     aws.BoolValue(config.BlockPublicPolicy),
-		"ignore_public_acls":      aws.BoolValue(config.IgnorePublicAcls),
-		"restrict_public_buckets": aws.BoolValue(config.RestrictPublicBuckets),
+		"aws_lambda": flattenAwsLambdaTransformation(apiObject.AwsLambda),
 	}}
 }
+
+func flattenAwsLambdaTransformation(apiObject *s3control.AwsLambdaTransformation) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"function_arn": aws.StringValue(apiObject.FunctionArn),
+	}
+
+	if v := apiObject.FunctionPayload; v != nil {
+		tfMap["function_payload"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
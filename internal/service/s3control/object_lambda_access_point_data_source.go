@@ -0,0 +1,147 @@
+package s3control
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceObjectLambdaAccessPoint() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectLambdaAccessPointRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"allowed_features": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloud_watch_metrics_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"supporting_access_point": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transformation_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"content_transformation": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aws_lambda": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"function_arn": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"function_payload": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceObjectLambdaAccessPointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	name := d.Get("name").(string)
+
+	output, err := FindObjectLambdaAccessPointByAccountIDAndName(conn, accountID, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Object Lambda Access Point (%s): %w", name, err)
+	}
+
+	d.SetId(ObjectLambdaAccessPointCreateResourceID(accountID, name))
+
+	d.Set("account_id", accountID)
+	d.Set("arn", arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "s3-object-lambda",
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("accesspoint/%s", name),
+	}.String())
+	d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	d.Set("name", name)
+
+	if output.Alias != nil {
+		d.Set("alias", aws.StringValue(output.Alias.Value))
+	}
+
+	configuration, err := conn.GetAccessPointConfigurationForObjectLambda(&s3control.GetAccessPointConfigurationForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Object Lambda Access Point (%s) configuration: %w", name, err)
+	}
+
+	if config := configuration.Configuration; config != nil {
+		d.Set("allowed_features", aws.StringValueSlice(config.AllowedFeatures))
+		d.Set("cloud_watch_metrics_enabled", aws.BoolValue(config.CloudWatchMetricsEnabled))
+		d.Set("supporting_access_point", aws.StringValue(config.SupportingAccessPoint))
+
+		if err := d.Set("transformation_configuration", flattenObjectLambdaTransformationConfigurations(config.TransformationConfigurations)); err != nil {
+			return fmt.Errorf("error setting transformation_configuration: %w", err)
+		}
+	}
+
+	return nil
+}
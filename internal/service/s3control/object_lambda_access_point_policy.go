@@ -0,0 +1,214 @@
+package s3control
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceObjectLambdaAccessPointPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceObjectLambdaAccessPointPolicyCreate,
+		Read:   resourceObjectLambdaAccessPointPolicyRead,
+		Update: resourceObjectLambdaAccessPointPolicyUpdate,
+		Delete: resourceObjectLambdaAccessPointPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"has_public_access_policy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+			},
+		},
+	}
+}
+
+func resourceObjectLambdaAccessPointPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	name := d.Get("name").(string)
+	resourceID := ObjectLambdaAccessPointCreateResourceID(accountID, name)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", d.Get("policy").(string), err)
+	}
+
+	input := &s3control.PutAccessPointPolicyForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+		Policy:    aws.String(policy),
+	}
+
+	log.Printf("[DEBUG] Creating S3 Object Lambda Access Point Policy: %s", input)
+	_, err = conn.PutAccessPointPolicyForObjectLambda(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Object Lambda Access Point Policy (%s): %w", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	return resourceObjectLambdaAccessPointPolicyRead(d, meta)
+}
+
+func resourceObjectLambdaAccessPointPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, name, err := ObjectLambdaAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	policy, err := FindObjectLambdaAccessPointPolicyByAccountIDAndName(conn, accountID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Object Lambda Access Point Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Object Lambda Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("name", name)
+	d.Set("policy", policy)
+
+	status, err := conn.GetAccessPointPolicyStatusForObjectLambda(&s3control.GetAccessPointPolicyStatusForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Object Lambda Access Point Policy (%s) status: %w", d.Id(), err)
+	}
+
+	d.Set("has_public_access_policy", false)
+	if status != nil && status.PolicyStatus != nil {
+		d.Set("has_public_access_policy", aws.BoolValue(status.PolicyStatus.IsPublic))
+	}
+
+	return nil
+}
+
+func resourceObjectLambdaAccessPointPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, name, err := ObjectLambdaAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", d.Get("policy").(string), err)
+	}
+
+	input := &s3control.PutAccessPointPolicyForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+		Policy:    aws.String(policy),
+	}
+
+	log.Printf("[DEBUG] Updating S3 Object Lambda Access Point Policy: %s", input)
+	_, err = conn.PutAccessPointPolicyForObjectLambda(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Object Lambda Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	return resourceObjectLambdaAccessPointPolicyRead(d, meta)
+}
+
+func resourceObjectLambdaAccessPointPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, name, err := ObjectLambdaAccessPointParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Object Lambda Access Point Policy: %s", d.Id())
+	_, err = conn.DeleteAccessPointPolicyForObjectLambda(&s3control.DeleteAccessPointPolicyForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3control.ErrCodeNoSuchAccessPointPolicy) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Object Lambda Access Point Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindObjectLambdaAccessPointPolicyByAccountIDAndName(conn *s3control.S3Control, accountID, name string) (string, error) {
+	input := &s3control.GetAccessPointPolicyForObjectLambdaInput{
+		AccountId: aws.String(accountID),
+		Name:      aws.String(name),
+	}
+
+	output, err := conn.GetAccessPointPolicyForObjectLambda(input)
+
+	if tfawserr.ErrCodeEquals(err, s3control.ErrCodeNoSuchAccessPointPolicy) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.Policy == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValue(output.Policy), nil
+}